@@ -1,4 +1,18 @@
 variable "env" {
   type    = string
   default = "prod"
-}
\ No newline at end of file
+
+  # S3 bucket names are "<env>-cloudtrail-<random_id.dec>", where the
+  # 8 byte random_id renders as up to 20 digits and names cap at 63
+  validation {
+    condition     = length(var.env) <= 31
+    error_message = "The env value must be 31 characters or fewer to keep S3 bucket names within 63 characters."
+  }
+
+  # env is embedded in S3 bucket names (lowercase only) and in the
+  # CloudTrail CloudWatch policy Sids (alphanumeric only)
+  validation {
+    condition     = can(regex("^[a-z0-9]+$", var.env))
+    error_message = "The env value must contain only lowercase letters and digits."
+  }
+}